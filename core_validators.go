@@ -0,0 +1,79 @@
+package authboss
+
+import (
+	"net/mail"
+	"regexp"
+	"strconv"
+
+	"github.com/volatiletech/authboss/v3/i18n"
+)
+
+// These are registered directly against DefaultValidators in this
+// package's init(), independent of the defaults package's own
+// snake_case catalog (defaults/validators.go), so that ValidateStruct's
+// documented `valid:"Required;Email;MinLength(8);MustMatch(^[a-z]+$)"`
+// tag syntax resolves even in an application that never imports
+// defaults. defaults additionally registers its lowercase names
+// (required, email, ...) into the same registry for Rules.Validators.
+const (
+	TagRequired  = "Required"
+	TagEmail     = "Email"
+	TagMinLength = "MinLength"
+	TagMaxLength = "MaxLength"
+	TagMustMatch = "MustMatch"
+)
+
+func init() {
+	DefaultValidators.RegisterValidation(TagRequired, func(value string, _ ...string) bool {
+		return len(value) > 0
+	}, func(t i18n.Translator, locale, field string, _ ...string) string {
+		return t.Translate(locale, "%s cannot be blank", field)
+	})
+
+	DefaultValidators.RegisterValidation(TagEmail, func(value string, _ ...string) bool {
+		_, err := mail.ParseAddress(value)
+		return err == nil
+	}, func(t i18n.Translator, locale, field string, _ ...string) string {
+		return t.Translate(locale, "%s must be a valid email address", field)
+	})
+
+	DefaultValidators.RegisterValidation(TagMinLength, func(value string, params ...string) bool {
+		n, ok := parseIntParam(params)
+		return !ok || len(value) >= n
+	}, func(t i18n.Translator, locale, field string, params ...string) string {
+		n, _ := parseIntParam(params)
+		return t.Translate(locale, "%s must be at least %d characters", field, n)
+	})
+
+	DefaultValidators.RegisterValidation(TagMaxLength, func(value string, params ...string) bool {
+		n, ok := parseIntParam(params)
+		return !ok || len(value) <= n
+	}, func(t i18n.Translator, locale, field string, params ...string) string {
+		n, _ := parseIntParam(params)
+		return t.Translate(locale, "%s must be at most %d characters", field, n)
+	})
+
+	DefaultValidators.RegisterValidation(TagMustMatch, func(value string, params ...string) bool {
+		if len(params) == 0 {
+			return true
+		}
+		re, err := regexp.Compile(params[0])
+		if err != nil {
+			return true
+		}
+		return re.MatchString(value)
+	}, func(t i18n.Translator, locale, field string, params ...string) string {
+		return t.Translate(locale, "%s is not in the correct format", field)
+	})
+}
+
+func parseIntParam(params []string) (int, bool) {
+	if len(params) == 0 {
+		return 0, false
+	}
+	n, err := strconv.Atoi(params[0])
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
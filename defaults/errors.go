@@ -0,0 +1,54 @@
+package defaults
+
+import "github.com/volatiletech/authboss/v3"
+
+// Field error codes. These are stable identifiers API consumers can
+// switch on to render their own localized/styled messages instead of
+// parsing the message text in FieldError's Err.
+const (
+	CodeRequired        = "required"
+	CodeInvalidValue    = "invalid_value"
+	CodeRegexMismatch   = "regex_mismatch"
+	CodeEmailInvalid    = "email_invalid"
+	CodeMinLength       = "min_length"
+	CodeMaxLength       = "max_length"
+	CodeMinLetters      = "min_letters"
+	CodeMinUpper        = "min_upper"
+	CodeMinLower        = "min_lower"
+	CodeMinNumeric      = "min_numeric"
+	CodeMinSymbols      = "min_symbols"
+	CodeNoWhitespace    = "no_whitespace"
+	CodeValidatorFailed = "validator_failed"
+	CodeURLInvalid      = "url_invalid"
+	CodeHostnameInvalid = "hostname_invalid"
+	CodePhoneInvalid    = "phone_invalid"
+)
+
+// FieldError is a validation error tied to a single form field. It
+// carries a plain message in Err for the existing form-rendering code
+// paths, plus a machine-readable Code and Params so JSON API consumers
+// can render their own per-rule messages instead of parsing English.
+type FieldError struct {
+	FieldName string
+	Err       error
+
+	Code   string
+	Params map[string]interface{}
+}
+
+// Error satisfies the error interface, returning "field: message" the
+// same way FieldError always has.
+func (f FieldError) Error() string {
+	return f.FieldName + ": " + f.Err.Error()
+}
+
+// RuleViolation converts f into the structured shape used by JSON
+// responders, satisfying authboss.CodedError.
+func (f FieldError) RuleViolation() authboss.RuleViolation {
+	return authboss.RuleViolation{
+		Field:   f.FieldName,
+		Code:    f.Code,
+		Params:  f.Params,
+		Message: f.Err.Error(),
+	}
+}
@@ -0,0 +1,64 @@
+package defaults
+
+import (
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+var (
+	e164Regex  = regexp.MustCompile(`^\+[1-9]\d{1,14}$`)
+	labelRegex = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`)
+)
+
+func validateEmail(email string) bool {
+	_, err := mail.ParseAddress(email)
+	return err == nil
+}
+
+// validateURL reports whether s parses as an absolute URL with a host and
+// a scheme in allowedSchemes. When allowedSchemes is empty it defaults to
+// http and https, which covers the oauth2/OIDC callback URLs this is
+// primarily meant to validate.
+func validateURL(s string, allowedSchemes ...string) bool {
+	if len(allowedSchemes) == 0 {
+		allowedSchemes = []string{"http", "https"}
+	}
+
+	u, err := url.Parse(s)
+	if err != nil || u.Host == "" {
+		return false
+	}
+
+	for _, scheme := range allowedSchemes {
+		if u.Scheme == scheme {
+			return true
+		}
+	}
+	return false
+}
+
+// validateE164 reports whether s is a phone number in E.164 format:
+// a leading +, a non-zero first digit, and up to 15 digits total.
+func validateE164(s string) bool {
+	return e164Regex.MatchString(s)
+}
+
+// validateHostname reports whether s is a valid RFC 1123 hostname: one
+// or more dot-separated labels, each 1-63 characters of letters, digits
+// and hyphens, neither starting nor ending with a hyphen. This is the
+// same rule sms2fa and oauth2 would otherwise each reimplement for
+// validating configured hosts.
+func validateHostname(s string) bool {
+	if len(s) == 0 || len(s) > 253 {
+		return false
+	}
+
+	for _, label := range strings.Split(s, ".") {
+		if !labelRegex.MatchString(label) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,81 @@
+package defaults
+
+import (
+	"crypto/sha1" //nolint:gosec // HIBP's k-anonymity API is keyed by SHA-1, not used for security here
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/friendsofgo/errors"
+)
+
+// HIBPChecker reports whether password has appeared in a known breach
+// corpus. PasswordPolicy.HIBP is optional; when set, PasswordPolicy.Errors
+// rejects passwords Pwned reports true for.
+type HIBPChecker interface {
+	Pwned(password string) (bool, error)
+}
+
+// HIBPKAnonymityChecker implements HIBPChecker against the Have I Been
+// Pwned Pwned Passwords API (or a compatible endpoint) using its
+// k-anonymity range search: only the first 5 hex characters of the
+// password's SHA-1 hash are sent over the network, and the full hash is
+// only ever compared locally, so the password itself never leaves the
+// process.
+type HIBPKAnonymityChecker struct {
+	// Endpoint defaults to the public HIBP range API. It must accept
+	// GET <Endpoint>/<5 hex chars> and return "SUFFIX:COUNT" lines.
+	Endpoint string
+	// Client defaults to http.DefaultClient.
+	Client *http.Client
+}
+
+const defaultHIBPEndpoint = "https://api.pwnedpasswords.com/range"
+
+// Pwned reports whether password's hash suffix appears in the range
+// response for its hash prefix.
+func (h HIBPKAnonymityChecker) Pwned(password string) (bool, error) {
+	sum := sha1.Sum([]byte(password)) //nolint:gosec
+	hash := strings.ToUpper(fmt.Sprintf("%x", sum))
+	prefix, suffix := hash[:5], hash[5:]
+
+	endpoint := h.Endpoint
+	if endpoint == "" {
+		endpoint = defaultHIBPEndpoint
+	}
+	client := h.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	resp, err := client.Get(endpoint + "/" + prefix)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to query HIBP range endpoint")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return false, errors.Errorf("HIBP range endpoint returned status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to read HIBP range response")
+	}
+
+	for _, line := range strings.Split(string(body), "\n") {
+		line = strings.TrimSpace(line)
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		if parts[0] == suffix {
+			count, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+			return err == nil && count > 0, nil
+		}
+	}
+
+	return false, nil
+}
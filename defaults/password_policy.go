@@ -0,0 +1,377 @@
+package defaults
+
+import (
+	_ "embed"
+	"math"
+	"regexp"
+	"strings"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/authboss/v3"
+)
+
+//go:embed commonpasswords.txt
+var commonPasswordsList string
+
+var commonPasswords = buildCommonPasswordSet(commonPasswordsList)
+
+func buildCommonPasswordSet(list string) map[string]struct{} {
+	set := make(map[string]struct{})
+	for _, line := range strings.Split(list, "\n") {
+		line = strings.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+		set[strings.ToLower(line)] = struct{}{}
+	}
+	return set
+}
+
+var (
+	leetReplacer = strings.NewReplacer(
+		"0", "o", "1", "i", "3", "e", "4", "a", "5", "s", "7", "t", "@", "a", "$", "s", "!", "i",
+	)
+	yearRegex = regexp.MustCompile(`(19|20)\d{2}`)
+)
+
+// qwertyRows are adjacency rows used to detect keyboard-walk passwords
+// like "qwerty" or "asdfgh", which are common but not dictionary words.
+var qwertyRows = []string{
+	"`1234567890-=",
+	"qwertyuiop[]",
+	"asdfghjkl;'",
+	"zxcvbnm,./",
+}
+
+// PasswordPolicy scores password strength the way zxcvbn does: rather
+// than just counting character classes (see Rules.MinUpper et al), it
+// estimates how many guesses a cracker would need by checking for
+// dictionary words (plus leet-speak substitutions), qwerty keyboard
+// walks, repeated runs, and four-digit years, then converts the total
+// guess estimate into a 0-4 score. Alphabetical sequences such as "abcd"
+// are not detected (digit runs such as "1234" are caught as keyboard
+// walks), and no date format other than a bare year is recognized.
+//
+// PasswordPolicy is meant to replace Rules' MinLetters/MinUpper/...
+// character-class checks in register/recover's password field
+// validation. This repo snapshot doesn't include those modules, so that
+// call-site swap isn't made here; integrating it is the same shape as
+// Rules.Errors - call PasswordPolicy{...}.Errors(password) wherever the
+// password field is currently validated.
+type PasswordPolicy struct {
+	// FieldName is used to build the FieldError this produces.
+	FieldName string
+	// MinScore is the minimum acceptable score (0-4); passwords scoring
+	// lower are rejected. 3 is a reasonable default for most apps.
+	MinScore int
+	// DenyCommon immediately rejects passwords found verbatim (or via a
+	// simple leet-speak undo) in the embedded list of about 190 common
+	// passwords (commonpasswords.txt), regardless of MinScore.
+	DenyCommon bool
+	// UserInputs are other values associated with the user (email,
+	// username, real name) that get penalized like dictionary words,
+	// since reusing them is an extremely common, extremely weak choice.
+	UserInputs []string
+	// HIBP, if set, additionally rejects passwords that appear in a
+	// breach corpus (see HIBPChecker).
+	HIBP HIBPChecker
+}
+
+// Errors reports why password fails the policy, or nil if it passes.
+func (p PasswordPolicy) Errors(password string) authboss.ErrorList {
+	var errs authboss.ErrorList
+
+	normalized := strings.ToLower(password)
+	if p.DenyCommon {
+		if _, ok := commonPasswords[normalized]; ok {
+			errs = append(errs, p.fieldError("This password is too common", CodeValidatorFailed))
+		} else if _, ok := commonPasswords[leetReplacer.Replace(normalized)]; ok {
+			errs = append(errs, p.fieldError("This password is too common", CodeValidatorFailed))
+		}
+	}
+
+	score := p.Score(password)
+	if score < p.MinScore {
+		errs = append(errs, p.fieldError("This password is too weak", CodeValidatorFailed))
+	}
+
+	if p.HIBP != nil {
+		pwned, err := p.HIBP.Pwned(password)
+		if err != nil {
+			errs = append(errs, p.fieldError("Could not verify password safety, please try again", CodeValidatorFailed))
+		} else if pwned {
+			errs = append(errs, p.fieldError("This password has appeared in a data breach, please choose another", CodeValidatorFailed))
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+func (p PasswordPolicy) fieldError(message, code string) FieldError {
+	return FieldError{FieldName: p.FieldName, Err: errors.New(message), Code: code}
+}
+
+// Score estimates password's strength as a 0 (guessed instantly) to 4
+// (very hard to guess) rating, the same scale zxcvbn uses.
+func (p PasswordPolicy) Score(password string) int {
+	guesses := estimateGuesses(password, p.UserInputs)
+	return scoreFromGuesses(guesses)
+}
+
+// patternMatch is one pattern matcher's claim on password[start:end],
+// along with the number of guesses needed to find that substring.
+type patternMatch struct {
+	start, end int
+	guesses    float64
+}
+
+// estimateGuesses returns a rough estimate of the number of guesses
+// needed to crack password, the way zxcvbn does: every pattern matcher
+// below proposes matches for substrings it recognizes (a dictionary
+// word, a keyboard walk, a repeat, a year), a single-character
+// brute-force match is proposed at every position as a fallback, and a
+// dynamic program finds the minimum-guesses way to parse the whole
+// password into a sequence of non-overlapping matches, multiplying
+// guesses across the sequence. This is what lets a password made of
+// several weak-looking pieces (e.g. a dictionary word plus a year) still
+// score as hard to guess overall when the *rest* of the password is
+// random, instead of the weakest single pattern anywhere in the string
+// collapsing the whole estimate to near zero.
+func estimateGuesses(password string, userInputs []string) float64 {
+	n := len(password)
+	if n == 0 {
+		return 1
+	}
+
+	cardinality := passwordCardinality(password)
+
+	var matches []patternMatch
+	matches = append(matches, dictionaryMatches(password, userInputs)...)
+	matches = append(matches, keyboardMatches(password)...)
+	matches = append(matches, repeatMatches(password)...)
+	matches = append(matches, dateMatches(password)...)
+	for i := 0; i < n; i++ {
+		matches = append(matches, patternMatch{start: i, end: i + 1, guesses: cardinality})
+	}
+
+	byEnd := make([][]patternMatch, n+1)
+	for _, m := range matches {
+		// Matchers that lowercase password report offsets into the
+		// lowercased string, which can be longer than password when
+		// lowercasing grows a character's encoding (e.g. "Ⱥ"); a match
+		// ending past the end of password is dropped.
+		if m.end > n {
+			continue
+		}
+		byEnd[m.end] = append(byEnd[m.end], m)
+	}
+
+	// dp[i] is the minimum guess count to explain password[:i]. Matches
+	// are only ever consulted by the end position they complete, and
+	// end > start always, so by the time dp[i] is computed every
+	// dp[m.start] it depends on has already been finalized.
+	dp := make([]float64, n+1)
+	dp[0] = 1
+	for i := 1; i <= n; i++ {
+		dp[i] = math.Inf(1)
+		for _, m := range byEnd[i] {
+			if candidate := dp[m.start] * m.guesses; candidate < dp[i] {
+				dp[i] = candidate
+			}
+		}
+	}
+
+	return dp[n]
+}
+
+// passwordCardinality estimates the size of the character set in play,
+// based on which classes (lower/upper/digit/symbol) appear anywhere in
+// password. It's the per-character guess count the brute-force fallback
+// match uses, so a password with no smarter matches anywhere degrades to
+// the classic cardinality^length brute-force estimate.
+func passwordCardinality(password string) float64 {
+	var hasLower, hasUpper, hasDigit, hasSymbol bool
+	for _, c := range password {
+		switch {
+		case c >= 'a' && c <= 'z':
+			hasLower = true
+		case c >= 'A' && c <= 'Z':
+			hasUpper = true
+		case c >= '0' && c <= '9':
+			hasDigit = true
+		default:
+			hasSymbol = true
+		}
+	}
+
+	cardinality := 0.0
+	if hasLower {
+		cardinality += 26
+	}
+	if hasUpper {
+		cardinality += 26
+	}
+	if hasDigit {
+		cardinality += 10
+	}
+	if hasSymbol {
+		cardinality += 33
+	}
+	if cardinality == 0 {
+		cardinality = 1
+	}
+
+	return cardinality
+}
+
+// dictionaryMatches finds every occurrence (not just a whole-string
+// match) of a common password or userInputs entry, checking both the
+// raw lowercased password and a leet-speak-undone version, since the
+// common-password list itself already contains digit-suffixed entries
+// like "password1" or "abc123" that a naive leet-undo would otherwise
+// mangle (leetReplacer turns the trailing "1" into "i", which no longer
+// matches "password1" in the list). leetReplacer only ever substitutes
+// one ASCII byte for another, so a match's position in the leeted string
+// is also its position in the lowercased one. That is the position in
+// the original password only when lowercasing keeps every character's
+// encoded length (always true for ASCII).
+func dictionaryMatches(password string, userInputs []string) []patternMatch {
+	lower := strings.ToLower(password)
+	leeted := leetReplacer.Replace(lower)
+
+	var matches []patternMatch
+	addAll := func(s, word string, guesses float64) {
+		for i := 0; i+len(word) <= len(s); {
+			idx := strings.Index(s[i:], word)
+			if idx < 0 {
+				return
+			}
+			start := i + idx
+			matches = append(matches, patternMatch{start: start, end: start + len(word), guesses: guesses})
+			i = start + 1
+		}
+	}
+
+	for word := range commonPasswords {
+		if len(word) < 4 {
+			continue
+		}
+		// Guess count is a rank-agnostic stand-in for "found on a
+		// common-password list at all" - longer entries are rarer, so
+		// scale gently with length rather than treating every hit as
+		// equally guessable.
+		guesses := 10 * float64(len(word))
+		addAll(lower, word, guesses)
+		if leeted != lower {
+			// A leet-speak match costs a cracker a little more than a
+			// literal one.
+			addAll(leeted, word, guesses*2)
+		}
+	}
+
+	for rank, input := range userInputs {
+		input = strings.ToLower(strings.TrimSpace(input))
+		if len(input) >= 4 {
+			addAll(lower, input, float64(rank+1))
+		}
+	}
+
+	return matches
+}
+
+// keyboardMatches finds maximal runs of adjacent keys on a qwerty
+// keyboard (e.g. "qwerty", "asdfgh") of length 4 or more anywhere in the
+// password, since walks are trivial to guess despite looking "random" by
+// character-class counting.
+func keyboardMatches(password string) []patternMatch {
+	lower := strings.ToLower(password)
+	var matches []patternMatch
+
+	for _, row := range qwertyRows {
+		start := 0
+		for start < len(lower) {
+			end := start + 1
+			for end < len(lower) {
+				prevIdx := strings.IndexByte(row, lower[end-1])
+				curIdx := strings.IndexByte(row, lower[end])
+				if prevIdx >= 0 && curIdx >= 0 && (curIdx == prevIdx+1 || curIdx == prevIdx-1) {
+					end++
+				} else {
+					break
+				}
+			}
+			if length := end - start; length >= 4 {
+				// Roughly as guessable as trying each starting key in
+				// each direction, times the walk's length.
+				matches = append(matches, patternMatch{
+					start:   start,
+					end:     end,
+					guesses: float64(len(row)) * 2 * float64(length),
+				})
+			}
+			start = end
+		}
+	}
+
+	return matches
+}
+
+// repeatMatches finds a single character (or short repeating unit) that
+// makes up most of a substring, e.g. "aaaaaaaa" or "abcabcabc".
+func repeatMatches(password string) []patternMatch {
+	n := len(password)
+	var matches []patternMatch
+
+	for unitLen := 1; unitLen <= n/3; unitLen++ {
+		for start := 0; start+unitLen*3 <= n; start++ {
+			unit := password[start : start+unitLen]
+			end := start + unitLen
+			for end+unitLen <= n && password[end:end+unitLen] == unit {
+				end += unitLen
+			}
+			if end-start >= unitLen*3 {
+				// Guessing a repeat is roughly: guess the unit, guess
+				// the repeat count.
+				matches = append(matches, patternMatch{start: start, end: end, guesses: float64(unitLen) * 4})
+			}
+		}
+	}
+
+	return matches
+}
+
+// dateMatches finds embedded four-digit years (1900-2099), a pattern
+// people lean on heavily for "memorable" passwords.
+func dateMatches(password string) []patternMatch {
+	var matches []patternMatch
+	for _, loc := range yearRegex.FindAllStringIndex(password, -1) {
+		// ~200 plausible years, near-instantly guessed once a cracker
+		// tries "word + year" combinations.
+		matches = append(matches, patternMatch{start: loc[0], end: loc[1], guesses: 200})
+	}
+	return matches
+}
+
+// scoreFromGuesses buckets a guess estimate into zxcvbn's familiar 0-4
+// score using the same order-of-magnitude thresholds.
+func scoreFromGuesses(guesses float64) int {
+	if guesses < 1 {
+		guesses = 1
+	}
+
+	switch {
+	case guesses < 1e3: // cracked in under a second
+		return 0
+	case guesses < 1e6:
+		return 1
+	case guesses < 1e8:
+		return 2
+	case guesses < 1e10:
+		return 3
+	default:
+		return 4
+	}
+}
@@ -0,0 +1,57 @@
+package defaults
+
+import "testing"
+
+func TestPasswordPolicyScoresCommonPasswordsLow(t *testing.T) {
+	policy := PasswordPolicy{}
+
+	weak := []string{"password1", "abc123", "qwerty123", "123456", "p4ssw0rd"}
+	for _, pw := range weak {
+		if score := policy.Score(pw); score > 1 {
+			t.Errorf("Score(%q) = %d, want <= 1 (common/weak password)", pw, score)
+		}
+	}
+}
+
+func TestPasswordPolicyScoresRandomPasswordsHigh(t *testing.T) {
+	policy := PasswordPolicy{}
+
+	if score := policy.Score("xK9$mQ2vL!pR7nW4"); score < 3 {
+		t.Errorf("Score(random) = %d, want >= 3", score)
+	}
+
+	// A trailing year shouldn't collapse the whole estimate: the rest of
+	// the password is still unguessable, so the year only costs the
+	// segment it actually covers.
+	if score := policy.Score("xK9$mQ2vL!pR7nW2019"); score < 3 {
+		t.Errorf("Score(random+year) = %d, want >= 3", score)
+	}
+
+	if score := policy.Score("MyVeryLongDiceware-Horse-Battery-2021"); score < 3 {
+		t.Errorf("Score(long passphrase+year) = %d, want >= 3", score)
+	}
+}
+
+func TestPasswordPolicyDenyCommon(t *testing.T) {
+	policy := PasswordPolicy{FieldName: "password", DenyCommon: true}
+
+	if errs := policy.Errors("password"); errs == nil {
+		t.Error("expected DenyCommon to reject a password straight from the common list")
+	}
+
+	if errs := policy.Errors("p4ssw0rd"); errs == nil {
+		t.Error("expected DenyCommon to reject a leet-speak common password")
+	}
+}
+
+func TestPasswordPolicyMinScore(t *testing.T) {
+	policy := PasswordPolicy{FieldName: "password", MinScore: 4}
+
+	if errs := policy.Errors("password1"); errs == nil {
+		t.Error("expected a common, low-entropy password to fail MinScore 4")
+	}
+
+	if errs := policy.Errors("xK9$mQ2vL!pR7nW4"); errs != nil {
+		t.Errorf("expected a high-entropy random password to pass MinScore 4, got %v", errs)
+	}
+}
@@ -0,0 +1,151 @@
+package defaults
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/authboss/v3"
+	"golang.org/x/text/unicode/norm"
+)
+
+// defaultReservedPIDs are names that collide with routes, admin tooling,
+// or infrastructure conventions (/.well-known, /api, /login, ...) that
+// authboss apps using the email- or username-as-PID pattern have
+// historically let users register as, each reimplementing the same
+// denylist (inconsistently) on their own.
+var defaultReservedPIDs = []string{
+	"admin", "administrator", "root", "support", "help",
+	"api", "login", "logout", "register", "signup", "signin",
+	"auth", "oauth", "account", "accounts", "settings",
+	"security", "staff", "moderator", "mod", "system",
+	"www", "mail", "ftp", "webmaster", "postmaster",
+	"noreply", "no-reply",
+}
+
+var defaultReservedPIDPrefixes = []string{".well-known"}
+
+// pidSegmentRegex enforces RFC 1123-style characters for PIDs used as a
+// URL path segment (e.g. /u/:username): letters, digits and hyphens,
+// neither leading nor trailing with one.
+var pidSegmentRegex = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+
+// confusables maps a small set of commonly-used homoglyphs (lookalike
+// codepoints from other scripts, and the ASCII characters most often
+// substituted in spoofing attempts) down to the latin letter they're
+// mistaken for. PIDPolicy uses this to "skeletonize" a PID before
+// comparing it against the reserved list, so e.g. a Cyrillic "а" can't
+// be used to register a visually-identical "admin".
+var confusables = map[rune]rune{
+	'а': 'a', 'е': 'e', 'і': 'i', 'о': 'o', 'р': 'p', // Cyrillic
+	'с': 'c', 'х': 'x', 'у': 'y', 'ѕ': 's',
+	'α': 'a', 'ο': 'o', // Greek
+	'0': 'o', '1': 'l', '3': 'e', '5': 's',
+}
+
+// PIDPolicy validates and normalizes a PID (the value auth/register look
+// up users by, usually an email or username) before it ever reaches a
+// database lookup: it normalizes case and Unicode form, rejects
+// homoglyph spoofs of reserved names, and enforces the character rules
+// needed to safely use the PID as a URL path segment.
+//
+// Callers are expected to call Errors (and use Normalize's result for
+// the lookup/insert) at the very top of their PID-handling path, before
+// ever touching storage. This repo snapshot doesn't include the
+// auth/register modules themselves, so that call site isn't wired up
+// here; an app integrating this would add, e.g. in register's
+// POST handler, `if errs := pidPolicy.Errors(pid); errs != nil { ... }`
+// immediately after reading pid from the request and before any
+// ServerStorer lookup.
+type PIDPolicy struct {
+	// Reserved is checked in addition to defaultReservedPIDs. Comparison
+	// is case-insensitive and homoglyph-aware (see confusables).
+	Reserved []string
+	// ReservedPrefixes is checked in addition to defaultReservedPIDPrefixes;
+	// a PID is rejected if it starts with any of them.
+	ReservedPrefixes []string
+	// RequirePathSafe enforces pidSegmentRegex, for apps that expose
+	// PIDs in URLs (e.g. /u/:username). Off by default since not every
+	// PID (an email address, say) is meant to appear in a path.
+	RequirePathSafe bool
+}
+
+// Normalize returns pid in its canonical form: NFKC-normalized,
+// lowercased, and trimmed of leading/trailing whitespace. auth/register
+// should look up and store users by this value, not the raw input, so
+// that two different Unicode encodings of the same visible PID can't
+// register as distinct accounts.
+func (p PIDPolicy) Normalize(pid string) string {
+	return strings.ToLower(strings.TrimSpace(norm.NFKC.String(pid)))
+}
+
+// Errors normalizes pid and reports why it's unusable, or nil if it's
+// fine to proceed to the database lookup/insert.
+func (p PIDPolicy) Errors(pid string) authboss.ErrorList {
+	var errs authboss.ErrorList
+
+	normalized := p.Normalize(pid)
+	if len(normalized) == 0 {
+		return append(errs, FieldError{FieldName: "pid", Err: errors.New("Cannot be blank"), Code: CodeRequired})
+	}
+
+	skeleton := skeletonize(normalized)
+	for _, reserved := range p.allReserved() {
+		if skeleton == skeletonize(reserved) {
+			errs = append(errs, FieldError{
+				FieldName: "pid",
+				Err:       errors.New("This name is reserved"),
+				Code:      CodeValidatorFailed,
+				Params:    map[string]interface{}{"reserved": reserved},
+			})
+			break
+		}
+	}
+
+	for _, prefix := range p.allReservedPrefixes() {
+		if strings.HasPrefix(normalized, prefix) {
+			errs = append(errs, FieldError{
+				FieldName: "pid",
+				Err:       errors.New("This name is reserved"),
+				Code:      CodeValidatorFailed,
+				Params:    map[string]interface{}{"reservedPrefix": prefix},
+			})
+			break
+		}
+	}
+
+	if p.RequirePathSafe && !pidSegmentRegex.MatchString(normalized) {
+		errs = append(errs, FieldError{
+			FieldName: "pid",
+			Err:       errors.New("Must contain only letters, numbers and hyphens, and may not start or end with a hyphen"),
+			Code:      CodeValidatorFailed,
+		})
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+func (p PIDPolicy) allReserved() []string {
+	return append(append([]string{}, defaultReservedPIDs...), p.Reserved...)
+}
+
+func (p PIDPolicy) allReservedPrefixes() []string {
+	return append(append([]string{}, defaultReservedPIDPrefixes...), p.ReservedPrefixes...)
+}
+
+// skeletonize lowercases s and maps each rune through confusables, so
+// visually similar strings compare equal regardless of which script or
+// leet-speak substitution produced them.
+func skeletonize(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if mapped, ok := confusables[r]; ok {
+			r = mapped
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
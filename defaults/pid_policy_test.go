@@ -0,0 +1,54 @@
+package defaults
+
+import "testing"
+
+func TestPIDPolicyRejectsReservedNames(t *testing.T) {
+	policy := PIDPolicy{}
+
+	cases := []string{"admin", "Admin", "  ADMIN  ", "root", "support"}
+	for _, pid := range cases {
+		if errs := policy.Errors(pid); errs == nil {
+			t.Errorf("expected %q to be rejected as reserved", pid)
+		}
+	}
+}
+
+func TestPIDPolicyRejectsHomoglyphSpoof(t *testing.T) {
+	policy := PIDPolicy{}
+
+	// "аdmin" uses a Cyrillic а (U+0430) in place of the latin a, which
+	// renders identically to "admin" but wouldn't match a naive
+	// case-insensitive string comparison against the reserved list.
+	spoofed := "аdmin"
+
+	if errs := policy.Errors(spoofed); errs == nil {
+		t.Errorf("expected homoglyph spoof %q of a reserved name to be rejected", spoofed)
+	}
+}
+
+func TestPIDPolicyAcceptsOrdinaryPID(t *testing.T) {
+	policy := PIDPolicy{}
+
+	if errs := policy.Errors("jane@example.com"); errs != nil {
+		t.Errorf("expected an ordinary PID to be accepted, got %v", errs)
+	}
+}
+
+func TestPIDPolicyCustomReserved(t *testing.T) {
+	policy := PIDPolicy{Reserved: []string{"acme-internal"}}
+
+	if errs := policy.Errors("acme-internal"); errs == nil {
+		t.Error("expected custom Reserved entry to be rejected")
+	}
+}
+
+func TestPIDPolicyRequirePathSafe(t *testing.T) {
+	policy := PIDPolicy{RequirePathSafe: true}
+
+	if errs := policy.Errors("jane@example.com"); errs == nil {
+		t.Error("expected an email-shaped PID to fail RequirePathSafe")
+	}
+	if errs := policy.Errors("jane-doe"); errs != nil {
+		t.Errorf("expected a hyphenated username to pass RequirePathSafe, got %v", errs)
+	}
+}
@@ -7,12 +7,18 @@ import (
 
 	"github.com/friendsofgo/errors"
 	"github.com/volatiletech/authboss/v3"
+	"github.com/volatiletech/authboss/v3/i18n"
 )
 
 var blankRegex = regexp.MustCompile(`^\s*$`)
 
-// Rules defines a ruleset by which a string can be validated.
-// The errors it produces are english only, with some basic pluralization.
+// Rules defines a ruleset by which a string can be validated. By default
+// its errors are english, with some basic pluralization; set Translator
+// and Locale to localize them instead. This repo snapshot doesn't
+// include the auth/register/recover HTTP handlers themselves, so
+// wiring Translator through from a request's negotiated locale is left
+// to the caller - it's a one-line `rules.Translator, rules.Locale =
+// appTranslator, req.Locale` before calling Errors.
 type Rules struct {
 	// FieldName is the name of the field this is intended to validate.
 	FieldName string
@@ -35,6 +41,66 @@ type Rules struct {
 	// UseRegexValidation allows opting out of the ParseAddress validation and reverting to the regular expression-based validation.
 	// If set to true, the regular expression-based validation will be used instead of the ParseAddress validation.
 	UseRegexValidation bool
+
+	// Validators names additional rules, registered against
+	// authboss.DefaultValidators (or Registry, if set), to run against
+	// the value alongside the built-in checks above.
+	Validators []string
+	// Registry overrides authboss.DefaultValidators as the source of
+	// named Validators. Nil means use authboss.DefaultValidators.
+	Registry *authboss.ValidatorRegistry
+
+	// Translator renders messages for the locale below. Nil means use
+	// i18n.Default, which reproduces today's hardcoded English text.
+	Translator i18n.Translator
+	// Locale is passed through to Translator; it has no effect on the
+	// built-in english fallback.
+	Locale string
+
+	// Format selects which built-in format validator runs when
+	// ValidationFunction is nil and UseRegexValidation is false. It
+	// defaults to FormatEmail, preserving today's behavior.
+	Format Format
+	// AllowedSchemes restricts which URL schemes FormatURL accepts.
+	// Empty means http and https.
+	AllowedSchemes []string
+}
+
+// Format selects a built-in format validator for Rules to run.
+type Format int
+
+const (
+	// FormatEmail validates with net/mail.ParseAddress. This is the
+	// zero value, so existing Rules values keep validating emails.
+	FormatEmail Format = iota
+	// FormatURL validates with validateURL, honoring AllowedSchemes.
+	FormatURL
+	// FormatHostname validates with validateHostname (RFC 1123 rules).
+	FormatHostname
+	// FormatPhone validates with validateE164.
+	FormatPhone
+	// FormatRegex defers to MustMatch, the same as UseRegexValidation.
+	// It exists so Format alone can select regex validation without
+	// also having to set UseRegexValidation.
+	FormatRegex
+	// FormatCustom defers to ValidationFunction, the same as setting
+	// ValidationFunction does on its own. It exists for symmetry so a
+	// caller can express "which format" purely through Format.
+	FormatCustom
+)
+
+func (r Rules) registry() *authboss.ValidatorRegistry {
+	if r.Registry != nil {
+		return r.Registry
+	}
+	return authboss.DefaultValidators
+}
+
+func (r Rules) translator() i18n.Translator {
+	if r.Translator != nil {
+		return r.Translator
+	}
+	return i18n.Default
 }
 
 // Errors returns an array of errors for each validation error that
@@ -42,49 +108,155 @@ type Rules struct {
 func (r Rules) Errors(toValidate string) authboss.ErrorList {
 	errs := make(authboss.ErrorList, 0)
 
+	t := r.translator()
+
 	ln := len(toValidate)
 	if r.Required && (ln == 0 || blankRegex.MatchString(toValidate)) {
-		return append(errs, FieldError{r.FieldName, errors.New("Cannot be blank")})
+		return append(errs, FieldError{
+			FieldName: r.FieldName,
+			Err:       errors.New(t.Translate(r.Locale, "Cannot be blank")),
+			Code:      CodeRequired,
+		})
 	}
 
-	if r.ValidationFunction != nil {
-		if !r.ValidationFunction(toValidate) {
-			errs = append(errs, FieldError{r.FieldName, errors.New("Invalid value")})
+	if r.ValidationFunction != nil || r.Format == FormatCustom {
+		if r.ValidationFunction != nil && !r.ValidationFunction(toValidate) {
+			errs = append(errs, FieldError{
+				FieldName: r.FieldName,
+				Err:       errors.New(t.Translate(r.Locale, "Invalid value")),
+				Code:      CodeInvalidValue,
+			})
 		}
-	} else if r.UseRegexValidation {
+	} else if r.UseRegexValidation || r.Format == FormatRegex {
 		if r.MustMatch != nil {
 			if !r.MustMatch.MatchString(toValidate) {
-				errs = append(errs, FieldError{r.FieldName, errors.New(r.MatchError)})
+				errs = append(errs, FieldError{
+					FieldName: r.FieldName,
+					Err:       errors.New(t.Translate(r.Locale, r.MatchError)),
+					Code:      CodeRegexMismatch,
+					Params:    map[string]interface{}{"pattern": r.MustMatch.String()},
+				})
 			}
 		}
 	} else {
-		if !validateEmail(toValidate) {
-			errs = append(errs, FieldError{r.FieldName, errors.New("Invalid email address")})
+		switch r.Format {
+		case FormatURL:
+			if !validateURL(toValidate, r.AllowedSchemes...) {
+				errs = append(errs, FieldError{
+					FieldName: r.FieldName,
+					Err:       errors.New(t.Translate(r.Locale, "Invalid URL")),
+					Code:      CodeURLInvalid,
+				})
+			}
+		case FormatHostname:
+			if !validateHostname(toValidate) {
+				errs = append(errs, FieldError{
+					FieldName: r.FieldName,
+					Err:       errors.New(t.Translate(r.Locale, "Invalid hostname")),
+					Code:      CodeHostnameInvalid,
+				})
+			}
+		case FormatPhone:
+			if !validateE164(toValidate) {
+				errs = append(errs, FieldError{
+					FieldName: r.FieldName,
+					Err:       errors.New(t.Translate(r.Locale, "Invalid phone number")),
+					Code:      CodePhoneInvalid,
+				})
+			}
+		default: // FormatEmail
+			if !validateEmail(toValidate) {
+				errs = append(errs, FieldError{
+					FieldName: r.FieldName,
+					Err:       errors.New(t.Translate(r.Locale, "Invalid email address")),
+					Code:      CodeEmailInvalid,
+				})
+			}
 		}
 	}
 
-	if (r.MinLength > 0 && ln < r.MinLength) || (r.MaxLength > 0 && ln > r.MaxLength) {
-		errs = append(errs, FieldError{r.FieldName, errors.New(r.lengthErr())})
+	if r.MinLength > 0 && ln < r.MinLength {
+		tmpl, args := r.lengthErr()
+		errs = append(errs, FieldError{
+			FieldName: r.FieldName,
+			Err:       errors.New(t.Translate(r.Locale, tmpl, args...)),
+			Code:      CodeMinLength,
+			Params:    map[string]interface{}{"min": r.MinLength},
+		})
+	} else if r.MaxLength > 0 && ln > r.MaxLength {
+		tmpl, args := r.lengthErr()
+		errs = append(errs, FieldError{
+			FieldName: r.FieldName,
+			Err:       errors.New(t.Translate(r.Locale, tmpl, args...)),
+			Code:      CodeMaxLength,
+			Params:    map[string]interface{}{"max": r.MaxLength},
+		})
 	}
 
 	upper, lower, numeric, symbols, whitespace := tallyCharacters(toValidate)
 	if upper+lower < r.MinLetters {
-		errs = append(errs, FieldError{r.FieldName, errors.New(r.charErr())})
+		tmpl, args := r.charErr()
+		errs = append(errs, FieldError{
+			FieldName: r.FieldName,
+			Err:       errors.New(t.Translate(r.Locale, tmpl, args...)),
+			Code:      CodeMinLetters,
+			Params:    map[string]interface{}{"min": r.MinLetters},
+		})
 	}
 	if upper < r.MinUpper {
-		errs = append(errs, FieldError{r.FieldName, errors.New(r.upperErr())})
+		tmpl, args := r.upperErr()
+		errs = append(errs, FieldError{
+			FieldName: r.FieldName,
+			Err:       errors.New(t.Translate(r.Locale, tmpl, args...)),
+			Code:      CodeMinUpper,
+			Params:    map[string]interface{}{"min": r.MinUpper},
+		})
 	}
 	if lower < r.MinLower {
-		errs = append(errs, FieldError{r.FieldName, errors.New(r.lowerErr())})
+		tmpl, args := r.lowerErr()
+		errs = append(errs, FieldError{
+			FieldName: r.FieldName,
+			Err:       errors.New(t.Translate(r.Locale, tmpl, args...)),
+			Code:      CodeMinLower,
+			Params:    map[string]interface{}{"min": r.MinLower},
+		})
 	}
 	if numeric < r.MinNumeric {
-		errs = append(errs, FieldError{r.FieldName, errors.New(r.numericErr())})
+		tmpl, args := r.numericErr()
+		errs = append(errs, FieldError{
+			FieldName: r.FieldName,
+			Err:       errors.New(t.Translate(r.Locale, tmpl, args...)),
+			Code:      CodeMinNumeric,
+			Params:    map[string]interface{}{"min": r.MinNumeric},
+		})
 	}
 	if symbols < r.MinSymbols {
-		errs = append(errs, FieldError{r.FieldName, errors.New(r.symbolErr())})
+		tmpl, args := r.symbolErr()
+		errs = append(errs, FieldError{
+			FieldName: r.FieldName,
+			Err:       errors.New(t.Translate(r.Locale, tmpl, args...)),
+			Code:      CodeMinSymbols,
+			Params:    map[string]interface{}{"min": r.MinSymbols},
+		})
 	}
 	if !r.AllowWhitespace && whitespace > 0 {
-		errs = append(errs, FieldError{r.FieldName, errors.New("No whitespace permitted")})
+		errs = append(errs, FieldError{
+			FieldName: r.FieldName,
+			Err:       errors.New(t.Translate(r.Locale, "No whitespace permitted")),
+			Code:      CodeNoWhitespace,
+		})
+	}
+
+	registry := r.registry()
+	for _, name := range r.Validators {
+		if ok, msg := registry.Validate(t, r.Locale, name, r.FieldName, toValidate); !ok {
+			errs = append(errs, FieldError{
+				FieldName: r.FieldName,
+				Err:       errors.New(msg),
+				Code:      CodeValidatorFailed,
+				Params:    map[string]interface{}{"validator": name},
+			})
+		}
 	}
 
 	if len(errs) == 0 {
@@ -99,7 +271,11 @@ func (r Rules) IsValid(toValidate string) bool {
 	return nil == r.Errors(toValidate)
 }
 
-// Rules returns an array of strings describing the rules.
+// Rules returns an array of strings describing the rules, always in
+// english - it's meant for rendering a plain-text rule list (e.g. "must
+// be at least 8 characters") next to a form field, not for a translated
+// error, so it formats the template itself rather than going through a
+// Translator.
 func (r Rules) Rules() []string {
 	var rules []string
 
@@ -107,95 +283,110 @@ func (r Rules) Rules() []string {
 		rules = append(rules, r.MatchError)
 	}
 
-	if e := r.lengthErr(); len(e) > 0 {
-		rules = append(rules, e)
+	if tmpl, args := r.lengthErr(); len(tmpl) > 0 {
+		rules = append(rules, fmt.Sprintf(tmpl, args...))
 	}
-	if e := r.charErr(); len(e) > 0 {
-		rules = append(rules, e)
+	if tmpl, args := r.charErr(); len(tmpl) > 0 {
+		rules = append(rules, fmt.Sprintf(tmpl, args...))
 	}
-	if e := r.upperErr(); len(e) > 0 {
-		rules = append(rules, e)
+	if tmpl, args := r.upperErr(); len(tmpl) > 0 {
+		rules = append(rules, fmt.Sprintf(tmpl, args...))
 	}
-	if e := r.lowerErr(); len(e) > 0 {
-		rules = append(rules, e)
+	if tmpl, args := r.lowerErr(); len(tmpl) > 0 {
+		rules = append(rules, fmt.Sprintf(tmpl, args...))
 	}
-	if e := r.numericErr(); len(e) > 0 {
-		rules = append(rules, e)
+	if tmpl, args := r.numericErr(); len(tmpl) > 0 {
+		rules = append(rules, fmt.Sprintf(tmpl, args...))
 	}
-	if e := r.symbolErr(); len(e) > 0 {
-		rules = append(rules, e)
+	if tmpl, args := r.symbolErr(); len(tmpl) > 0 {
+		rules = append(rules, fmt.Sprintf(tmpl, args...))
 	}
 
 	return rules
 }
 
-func (r Rules) lengthErr() (err string) {
+// lengthErr and the *Err helpers below return a message template (a
+// fmt/Translate-style verb string) and the args to fill it, rather than
+// an already-interpolated sentence. That's what lets Errors hand both
+// through to Translator.Translate as (key, args...) - a catalog-backed
+// translator can look up the template itself and only needs N entries
+// per language instead of one per numeric value - while Rules above
+// still formats the same template directly for its always-english
+// rule-list use case.
+func (r Rules) lengthErr() (tmpl string, args []interface{}) {
 	switch {
 	case r.MinLength > 0 && r.MaxLength > 0:
-		err = fmt.Sprintf("Must be between %d and %d characters", r.MinLength, r.MaxLength)
+		return "Must be between %d and %d characters", []interface{}{r.MinLength, r.MaxLength}
 	case r.MinLength > 0:
-		err = fmt.Sprintf("Must be at least %d character", r.MinLength)
+		tmpl = "Must be at least %d character"
 		if r.MinLength > 1 {
-			err += "s"
+			tmpl += "s"
 		}
+		return tmpl, []interface{}{r.MinLength}
 	case r.MaxLength > 0:
-		err = fmt.Sprintf("Must be at most %d character", r.MaxLength)
+		tmpl = "Must be at most %d character"
 		if r.MaxLength > 1 {
-			err += "s"
+			tmpl += "s"
 		}
+		return tmpl, []interface{}{r.MaxLength}
 	}
 
-	return err
+	return "", nil
 }
 
-func (r Rules) charErr() (err string) {
+func (r Rules) charErr() (tmpl string, args []interface{}) {
 	if r.MinLetters > 0 {
-		err = fmt.Sprintf("Must contain at least %d letter", r.MinLetters)
+		tmpl = "Must contain at least %d letter"
 		if r.MinLetters > 1 {
-			err += "s"
+			tmpl += "s"
 		}
+		args = []interface{}{r.MinLetters}
 	}
-	return err
+	return tmpl, args
 }
 
-func (r Rules) upperErr() (err string) {
+func (r Rules) upperErr() (tmpl string, args []interface{}) {
 	if r.MinUpper > 0 {
-		err = fmt.Sprintf("Must contain at least %d uppercase letter", r.MinUpper)
+		tmpl = "Must contain at least %d uppercase letter"
 		if r.MinUpper > 1 {
-			err += "s"
+			tmpl += "s"
 		}
+		args = []interface{}{r.MinUpper}
 	}
-	return err
+	return tmpl, args
 }
 
-func (r Rules) lowerErr() (err string) {
+func (r Rules) lowerErr() (tmpl string, args []interface{}) {
 	if r.MinLower > 0 {
-		err = fmt.Sprintf("Must contain at least %d lowercase letter", r.MinLower)
+		tmpl = "Must contain at least %d lowercase letter"
 		if r.MinLower > 1 {
-			err += "s"
+			tmpl += "s"
 		}
+		args = []interface{}{r.MinLower}
 	}
-	return err
+	return tmpl, args
 }
 
-func (r Rules) numericErr() (err string) {
+func (r Rules) numericErr() (tmpl string, args []interface{}) {
 	if r.MinNumeric > 0 {
-		err = fmt.Sprintf("Must contain at least %d number", r.MinNumeric)
+		tmpl = "Must contain at least %d number"
 		if r.MinNumeric > 1 {
-			err += "s"
+			tmpl += "s"
 		}
+		args = []interface{}{r.MinNumeric}
 	}
-	return err
+	return tmpl, args
 }
 
-func (r Rules) symbolErr() (err string) {
+func (r Rules) symbolErr() (tmpl string, args []interface{}) {
 	if r.MinSymbols > 0 {
-		err = fmt.Sprintf("Must contain at least %d symbol", r.MinSymbols)
+		tmpl = "Must contain at least %d symbol"
 		if r.MinSymbols > 1 {
-			err += "s"
+			tmpl += "s"
 		}
+		args = []interface{}{r.MinSymbols}
 	}
-	return err
+	return tmpl, args
 }
 
 func tallyCharacters(s string) (upper, lower, numeric, symbols, whitespace int) {
@@ -218,10 +409,3 @@ func tallyCharacters(s string) (upper, lower, numeric, symbols, whitespace int)
 
 	return upper, lower, numeric, symbols, whitespace
 }
-
-import "net/mail"
-
-func validateEmail(email string) bool {
-	_, err := mail.ParseAddress(email)
-	return err == nil
-}
@@ -0,0 +1,44 @@
+package defaults
+
+import (
+	"testing"
+
+	"github.com/volatiletech/authboss/v3/i18n"
+)
+
+// recordingTranslator captures the keys it was asked to translate, so
+// tests can assert Rules hands a templated key through instead of an
+// already-interpolated English sentence.
+type recordingTranslator struct {
+	keys []string
+}
+
+func (r *recordingTranslator) Translate(_, key string, args ...interface{}) string {
+	r.keys = append(r.keys, key)
+	return i18n.Default.Translate("", key, args...)
+}
+
+func TestRulesErrorsTranslatesLengthTemplateNotSentence(t *testing.T) {
+	tr := &recordingTranslator{}
+	rules := Rules{FieldName: "password", MinLength: 8, MaxLength: 20, Translator: tr}
+
+	if errs := rules.Errors("short"); errs == nil {
+		t.Fatal("expected a MinLength violation for a 5 character value")
+	}
+
+	for _, key := range tr.keys {
+		if key == "Must be between 8 and 20 characters" {
+			t.Fatalf("Translate was called with an already-interpolated sentence %q, want the template key", key)
+		}
+	}
+
+	found := false
+	for _, key := range tr.keys {
+		if key == "Must be between %d and %d characters" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("expected Translate to be called with the length template key, got %v", tr.keys)
+	}
+}
@@ -0,0 +1,266 @@
+package defaults
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/volatiletech/authboss/v3"
+	"github.com/volatiletech/authboss/v3/i18n"
+)
+
+// Names of the validators this package registers into
+// authboss.DefaultValidators. Rules.Validators and struct `valid` tags
+// reference rules by these names.
+const (
+	ValidatorRequired     = "required"
+	ValidatorEmail        = "email"
+	ValidatorURL          = "url"
+	ValidatorHostname     = "hostname"
+	ValidatorMinLen       = "min_len"
+	ValidatorStartsWith   = "starts_with"
+	ValidatorEndsWith     = "ends_with"
+	ValidatorAlphanum     = "alphanum"
+	ValidatorAlphanumDash = "alphanum_dash"
+	ValidatorNotReserved  = "not_reserved"
+	ValidatorCreditCard   = "credit_card"
+	ValidatorE164Phone    = "e164_phone"
+	ValidatorISBN         = "isbn"
+)
+
+var (
+	alphanumRegex     = regexp.MustCompile(`^[A-Za-z0-9]+$`)
+	alphanumDashRegex = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+	isbnCharsRegex    = regexp.MustCompile(`^[0-9Xx-]+$`)
+)
+
+func init() {
+	authboss.DefaultValidators.RegisterValidation(ValidatorRequired, validateRequired, msgRequired)
+	authboss.DefaultValidators.RegisterValidation(ValidatorEmail, func(value string, _ ...string) bool {
+		return validateEmail(value)
+	}, msgEmail)
+	authboss.DefaultValidators.RegisterValidation(ValidatorURL, func(value string, params ...string) bool {
+		return validateURL(value, params...)
+	}, msgURL)
+	authboss.DefaultValidators.RegisterValidation(ValidatorHostname, func(value string, _ ...string) bool {
+		return validateHostname(value)
+	}, msgHostname)
+	authboss.DefaultValidators.RegisterValidation(ValidatorMinLen, validateMinLen, msgMinLen)
+	authboss.DefaultValidators.RegisterValidation(ValidatorStartsWith, validateStartsWith, msgStartsWith)
+	authboss.DefaultValidators.RegisterValidation(ValidatorEndsWith, validateEndsWith, msgEndsWith)
+	authboss.DefaultValidators.RegisterValidation(ValidatorAlphanum, func(value string, _ ...string) bool {
+		return alphanumRegex.MatchString(value)
+	}, msgAlphanum)
+	authboss.DefaultValidators.RegisterValidation(ValidatorAlphanumDash, func(value string, _ ...string) bool {
+		return alphanumDashRegex.MatchString(value)
+	}, msgAlphanumDash)
+	authboss.DefaultValidators.RegisterValidation(ValidatorNotReserved, validateNotReserved, msgNotReserved)
+	authboss.DefaultValidators.RegisterValidation(ValidatorCreditCard, func(value string, _ ...string) bool {
+		return validateLuhn(value)
+	}, msgCreditCard)
+	authboss.DefaultValidators.RegisterValidation(ValidatorE164Phone, func(value string, _ ...string) bool {
+		return validateE164(value)
+	}, msgE164Phone)
+	authboss.DefaultValidators.RegisterValidation(ValidatorISBN, func(value string, _ ...string) bool {
+		return validateISBN(value)
+	}, msgISBN)
+}
+
+func validateRequired(value string, _ ...string) bool {
+	return len(value) > 0 && !blankRegex.MatchString(value)
+}
+
+func msgRequired(t i18n.Translator, locale, field string, _ ...string) string {
+	return t.Translate(locale, "%s cannot be blank", field)
+}
+
+func msgEmail(t i18n.Translator, locale, field string, _ ...string) string {
+	return t.Translate(locale, "%s must be a valid email address", field)
+}
+
+func msgURL(t i18n.Translator, locale, field string, _ ...string) string {
+	return t.Translate(locale, "%s must be a valid URL", field)
+}
+
+func msgHostname(t i18n.Translator, locale, field string, _ ...string) string {
+	return t.Translate(locale, "%s must be a valid hostname", field)
+}
+
+// validateMinLen checks that value is at least params[0] characters long.
+// It's registered under ValidatorMinLen so struct tags can use
+// min_len(8); Rules itself keeps its own MinLength field for this purpose.
+func validateMinLen(value string, params ...string) bool {
+	if len(params) == 0 {
+		return true
+	}
+	n, err := strconv.Atoi(params[0])
+	if err != nil {
+		return true
+	}
+	return len(value) >= n
+}
+
+func msgMinLen(t i18n.Translator, locale, field string, params ...string) string {
+	n := ""
+	if len(params) > 0 {
+		n = params[0]
+	}
+	return t.Translate(locale, "%s must be at least %s characters", field, n)
+}
+
+func validateStartsWith(value string, params ...string) bool {
+	if len(params) == 0 {
+		return true
+	}
+	return strings.HasPrefix(value, params[0])
+}
+
+func msgStartsWith(t i18n.Translator, locale, field string, params ...string) string {
+	prefix := ""
+	if len(params) > 0 {
+		prefix = params[0]
+	}
+	return t.Translate(locale, "%s must start with %q", field, prefix)
+}
+
+func validateEndsWith(value string, params ...string) bool {
+	if len(params) == 0 {
+		return true
+	}
+	return strings.HasSuffix(value, params[0])
+}
+
+func msgEndsWith(t i18n.Translator, locale, field string, params ...string) string {
+	suffix := ""
+	if len(params) > 0 {
+		suffix = params[0]
+	}
+	return t.Translate(locale, "%s must end with %q", field, suffix)
+}
+
+func msgAlphanum(t i18n.Translator, locale, field string, _ ...string) string {
+	return t.Translate(locale, "%s must contain only letters and numbers", field)
+}
+
+func msgAlphanumDash(t i18n.Translator, locale, field string, _ ...string) string {
+	return t.Translate(locale, "%s must contain only letters, numbers, dashes and underscores", field)
+}
+
+// validateNotReserved rejects values that case-insensitively match one of
+// params. PIDPolicy uses a richer, dedicated version of this check; this
+// one exists so the rule is available standalone via the registry and
+// struct tags.
+func validateNotReserved(value string, params ...string) bool {
+	lower := strings.ToLower(value)
+	for _, p := range params {
+		if lower == strings.ToLower(p) {
+			return false
+		}
+	}
+	return true
+}
+
+func msgNotReserved(t i18n.Translator, locale, field string, _ ...string) string {
+	return t.Translate(locale, "%s is reserved and cannot be used", field)
+}
+
+func msgCreditCard(t i18n.Translator, locale, field string, _ ...string) string {
+	return t.Translate(locale, "%s is not a valid credit card number", field)
+}
+
+func msgE164Phone(t i18n.Translator, locale, field string, _ ...string) string {
+	return t.Translate(locale, "%s must be a valid phone number in E.164 format", field)
+}
+
+func msgISBN(t i18n.Translator, locale, field string, _ ...string) string {
+	return t.Translate(locale, "%s is not a valid ISBN", field)
+}
+
+// validateLuhn reports whether value passes the Luhn checksum used by
+// credit card numbers. Spaces and dashes are ignored; any other non-digit
+// character, or fewer than 12 digits, makes value invalid.
+func validateLuhn(value string) bool {
+	var digits []int
+	for _, c := range value {
+		if unicode.IsSpace(c) || c == '-' {
+			continue
+		}
+		if !unicode.IsDigit(c) {
+			return false
+		}
+		digits = append(digits, int(c-'0'))
+	}
+	if len(digits) < 12 {
+		return false
+	}
+
+	sum := 0
+	parity := len(digits) % 2
+	for i, d := range digits {
+		if i%2 == parity {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+
+	return sum%10 == 0
+}
+
+// validateISBN reports whether value is a well-formed ISBN-10 or ISBN-13,
+// ignoring dashes and spaces.
+func validateISBN(value string) bool {
+	cleaned := strings.ReplaceAll(strings.ReplaceAll(value, "-", ""), " ", "")
+	if !isbnCharsRegex.MatchString(cleaned) {
+		return false
+	}
+
+	switch len(cleaned) {
+	case 10:
+		return validateISBN10(cleaned)
+	case 13:
+		return validateISBN13(cleaned)
+	default:
+		return false
+	}
+}
+
+func validateISBN10(isbn string) bool {
+	sum := 0
+	for i := 0; i < 10; i++ {
+		c := isbn[i]
+		var v int
+		switch {
+		case c == 'X' || c == 'x':
+			if i != 9 {
+				return false
+			}
+			v = 10
+		case c >= '0' && c <= '9':
+			v = int(c - '0')
+		default:
+			return false
+		}
+		sum += (10 - i) * v
+	}
+	return sum%11 == 0
+}
+
+func validateISBN13(isbn string) bool {
+	sum := 0
+	for i := 0; i < 13; i++ {
+		c := isbn[i]
+		if c < '0' || c > '9' {
+			return false
+		}
+		v := int(c - '0')
+		if i%2 == 1 {
+			v *= 3
+		}
+		sum += v
+	}
+	return sum%10 == 0
+}
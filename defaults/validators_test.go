@@ -0,0 +1,101 @@
+package defaults
+
+import "testing"
+
+func TestValidateLuhn(t *testing.T) {
+	cases := []struct {
+		in   string
+		want bool
+	}{
+		{"4111111111111111", true}, // well-known Visa test number
+		{"4111 1111 1111 1111", true},
+		{"4111111111111112", false}, // bad checksum digit
+		{"not-a-card", false},
+		{"123", false}, // too short
+	}
+
+	for _, c := range cases {
+		if got := validateLuhn(c.in); got != c.want {
+			t.Errorf("validateLuhn(%q) = %v, want %v", c.in, got, c.want)
+		}
+	}
+}
+
+func TestValidateISBN(t *testing.T) {
+	cases := []struct {
+		in   string
+		want bool
+	}{
+		{"0-306-40615-2", true}, // well-known ISBN-10 example
+		{"0306406152", true},
+		{"978-0-306-40615-7", true}, // its ISBN-13 equivalent
+		{"9780306406157", true},
+		{"0-306-40615-3", false}, // bad check digit
+		{"not-an-isbn", false},
+	}
+
+	for _, c := range cases {
+		if got := validateISBN(c.in); got != c.want {
+			t.Errorf("validateISBN(%q) = %v, want %v", c.in, got, c.want)
+		}
+	}
+}
+
+func TestValidateURL(t *testing.T) {
+	cases := []struct {
+		in      string
+		schemes []string
+		want    bool
+	}{
+		{"https://example.com/callback", nil, true},
+		{"http://example.com", nil, true},
+		{"ftp://example.com", nil, false},
+		{"ftp://example.com", []string{"ftp"}, true},
+		{"not a url", nil, false},
+		{"https:///missing-host", nil, false},
+	}
+
+	for _, c := range cases {
+		if got := validateURL(c.in, c.schemes...); got != c.want {
+			t.Errorf("validateURL(%q, %v) = %v, want %v", c.in, c.schemes, got, c.want)
+		}
+	}
+}
+
+func TestValidateHostname(t *testing.T) {
+	cases := []struct {
+		in   string
+		want bool
+	}{
+		{"example.com", true},
+		{"sub.example.com", true},
+		{"-bad.example.com", false},
+		{"bad-.example.com", false},
+		{"", false},
+	}
+
+	for _, c := range cases {
+		if got := validateHostname(c.in); got != c.want {
+			t.Errorf("validateHostname(%q) = %v, want %v", c.in, got, c.want)
+		}
+	}
+}
+
+func TestValidateE164(t *testing.T) {
+	cases := []struct {
+		in   string
+		want bool
+	}{
+		{"+14155552671", true},
+		{"+442071838750", true},
+		{"14155552671", false}, // missing +
+		{"+0123456789", false}, // leading zero after +
+		{"not-a-number", false},
+	}
+
+	for _, c := range cases {
+		if got := validateE164(c.in); got != c.want {
+			t.Errorf("validateE164(%q) = %v, want %v", c.in, got, c.want)
+		}
+	}
+}
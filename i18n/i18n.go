@@ -0,0 +1,30 @@
+// Package i18n provides the minimal translation abstraction that authboss's
+// validators and HTTP responders use to render user-facing messages. It
+// exists so that modules never hardcode English strings directly; instead
+// they look messages up by key through a Translator, which applications can
+// swap out for something backed by gettext, go-i18n, or any other catalog.
+package i18n
+
+import "fmt"
+
+// Translator converts a message key (plus format arguments) into display
+// text for the given locale. Implementations should fall back to english
+// when locale is empty or the key has no translation for it.
+type Translator interface {
+	Translate(locale, key string, args ...interface{}) string
+}
+
+// Default is the Translator authboss falls back to when an application
+// hasn't configured one of its own. It ignores locale entirely and treats
+// key as an english fmt.Sprintf template, which preserves today's
+// hardcoded-English behavior for apps that don't opt into localization.
+var Default Translator = enTranslator{}
+
+type enTranslator struct{}
+
+func (enTranslator) Translate(_ string, key string, args ...interface{}) string {
+	if len(args) == 0 {
+		return key
+	}
+	return fmt.Sprintf(key, args...)
+}
@@ -0,0 +1,180 @@
+package authboss
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/volatiletech/authboss/v3/i18n"
+)
+
+// StructValidator is a validation rule that operates on an arbitrary
+// struct field value (as opposed to Validator, which only ever sees a
+// string). It reports whether value passes, and if not, a message to
+// show the user. Register one with AddCustomValidator so it becomes
+// available to `valid` struct tags parsed by ValidateStruct.
+type StructValidator func(value interface{}, params []string) (ok bool, message string)
+
+var customValidators = make(map[string]StructValidator)
+
+// AddCustomValidator registers a StructValidator under name so it can be
+// referenced from a `valid:"..."` struct tag, the same way the built-in
+// rule names (Required, Email, MinLength, MustMatch, ...) are.
+func AddCustomValidator(name string, fn StructValidator) {
+	customValidators[name] = fn
+}
+
+// StructFieldError is a validation error produced by ValidateStruct. It's
+// deliberately simpler than defaults.FieldError (no Code/Params fields)
+// since core can't depend on defaults: its RuleViolation always reports
+// Code "invalid" with no Params. Apps that need per-rule codes can
+// satisfy CodedError on their own error types, or use
+// defaults.Rules/PasswordPolicy, which already do.
+type StructFieldError struct {
+	Field string
+	Err   error
+}
+
+// Error satisfies the error interface.
+func (f StructFieldError) Error() string {
+	return f.Field + ": " + f.Err.Error()
+}
+
+// RuleViolation satisfies CodedError so ErrorList.MarshalJSON can render
+// ValidateStruct's errors the same way it does defaults.FieldError's.
+func (f StructFieldError) RuleViolation() RuleViolation {
+	return RuleViolation{Field: f.Field, Code: "invalid", Message: f.Err.Error()}
+}
+
+// structTagRule is one `;`-separated rule parsed out of a `valid` tag,
+// e.g. "MinLength(8)" becomes {Name: "MinLength", Params: []string{"8"}}.
+type structTagRule struct {
+	Name   string
+	Params []string
+}
+
+func parseValidTag(tag string) []structTagRule {
+	var rules []structTagRule
+	for _, segment := range strings.Split(tag, ";") {
+		segment = strings.TrimSpace(segment)
+		if len(segment) == 0 {
+			continue
+		}
+
+		name := segment
+		var params []string
+		if open := strings.IndexByte(segment, '('); open >= 0 && strings.HasSuffix(segment, ")") {
+			name = segment[:open]
+			inner := segment[open+1 : len(segment)-1]
+			if len(inner) > 0 {
+				params = splitParams(inner)
+			}
+		}
+
+		rules = append(rules, structTagRule{Name: name, Params: params})
+	}
+	return rules
+}
+
+// splitParams splits a rule's parameter list on top-level commas only,
+// treating (), [] and {} as nesting that protects the commas inside them.
+// A naive strings.Split(inner, ",") corrupts any param containing a
+// comma of its own - most commonly a regex passed to MustMatch, where an
+// extremely ordinary quantifier like "{3,5}" would otherwise be sliced in
+// two. Params given inside matched delimiters (like a regex's grouping or
+// counting syntax) come through untouched; only commas outside any
+// nesting act as separators.
+func splitParams(inner string) []string {
+	var params []string
+	depth := 0
+	start := 0
+	for i := 0; i < len(inner); i++ {
+		switch inner[i] {
+		case '(', '[', '{':
+			depth++
+		case ')', ']', '}':
+			if depth > 0 {
+				depth--
+			}
+		case ',':
+			if depth == 0 {
+				params = append(params, strings.TrimSpace(inner[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	params = append(params, strings.TrimSpace(inner[start:]))
+	return params
+}
+
+// ValidateStruct walks v's fields (v must be a struct or pointer to one)
+// and runs the rules in each field's `valid:"..."` tag, e.g.
+// `valid:"Required;Email;MinLength(8);MustMatch(^[a-z]+$)"`. Rule names
+// are first looked up among custom validators registered with
+// AddCustomValidator, then DefaultValidators, so applications get both
+// built-ins and their own rules from the same tag syntax. Required,
+// Email, MinLength, MaxLength and MustMatch are registered against
+// DefaultValidators by this package's own init() (see
+// core_validators.go), so the tag syntax above resolves without the
+// caller needing to import defaults; defaults additionally layers its
+// own lowercase names (required, email, ...) onto the same registry for
+// Rules.Validators.
+//
+// This is intended to let register/confirm validate the User
+// implementation's struct directly instead of requiring every app to
+// hand-wire a BodyReader.Validate() that re-describes the same rules;
+// this repo snapshot doesn't include those modules, so that wiring
+// isn't done here.
+func ValidateStruct(v interface{}) ErrorList {
+	var errs ErrorList
+
+	val := reflect.ValueOf(v)
+	for val.Kind() == reflect.Ptr {
+		if val.IsNil() {
+			return nil
+		}
+		val = val.Elem()
+	}
+	if val.Kind() != reflect.Struct {
+		return nil
+	}
+
+	typ := val.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		tag, ok := field.Tag.Lookup("valid")
+		if !ok || tag == "-" {
+			continue
+		}
+
+		fieldValue := val.Field(i).Interface()
+		stringValue := fmt.Sprintf("%v", fieldValue)
+
+		for _, rule := range parseValidTag(tag) {
+			if fn, ok := customValidators[rule.Name]; ok {
+				if ok, message := fn(fieldValue, rule.Params); !ok {
+					errs = append(errs, StructFieldError{Field: field.Name, Err: errors.New(message)})
+				}
+				continue
+			}
+
+			validator, msgFn, ok := DefaultValidators.Lookup(rule.Name)
+			if !ok {
+				continue
+			}
+			if !validator(stringValue, rule.Params...) {
+				message := field.Name + " is invalid"
+				if msgFn != nil {
+					message = msgFn(i18n.Default, "", field.Name, rule.Params...)
+				}
+				errs = append(errs, StructFieldError{Field: field.Name, Err: errors.New(message)})
+			}
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
@@ -0,0 +1,65 @@
+package authboss
+
+import "testing"
+
+type testUser struct {
+	Email    string `valid:"Required;Email"`
+	Username string `valid:"Required;MinLength(4)"`
+	Bio      string
+}
+
+func TestValidateStructRejectsInvalidFields(t *testing.T) {
+	u := testUser{Email: "not-an-email", Username: "ab", Bio: "anything goes"}
+
+	errs := ValidateStruct(&u)
+	if errs == nil {
+		t.Fatal("expected errors for invalid Email and Username, got nil")
+	}
+	if len(errs) != 2 {
+		t.Fatalf("expected 2 errors, got %d: %v", len(errs), errs)
+	}
+}
+
+func TestValidateStructAcceptsValidFields(t *testing.T) {
+	u := testUser{Email: "user@example.com", Username: "gopher", Bio: "anything goes"}
+
+	if errs := ValidateStruct(&u); errs != nil {
+		t.Fatalf("expected no errors, got %v", errs)
+	}
+}
+
+func TestValidateStructMustMatchQuantifier(t *testing.T) {
+	type pin struct {
+		Code string `valid:"MustMatch(^[0-9]{3,5}$)"`
+	}
+
+	if errs := ValidateStruct(&pin{Code: "123"}); errs != nil {
+		t.Fatalf("expected %q to satisfy ^[0-9]{3,5}$, got %v", "123", errs)
+	}
+	if errs := ValidateStruct(&pin{Code: "12"}); errs == nil {
+		t.Fatal("expected \"12\" to fail ^[0-9]{3,5}$")
+	}
+}
+
+func TestValidateStructCustomValidator(t *testing.T) {
+	AddCustomValidator("NoSpaces", func(value interface{}, _ []string) (bool, string) {
+		s, _ := value.(string)
+		for _, c := range s {
+			if c == ' ' {
+				return false, "must not contain spaces"
+			}
+		}
+		return true, ""
+	})
+
+	type slug struct {
+		Name string `valid:"NoSpaces"`
+	}
+
+	if errs := ValidateStruct(&slug{Name: "has spaces"}); errs == nil {
+		t.Fatal("expected custom validator to reject a value with spaces")
+	}
+	if errs := ValidateStruct(&slug{Name: "no-spaces"}); errs != nil {
+		t.Fatalf("expected custom validator to accept a value without spaces, got %v", errs)
+	}
+}
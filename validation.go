@@ -0,0 +1,83 @@
+package authboss
+
+import "github.com/volatiletech/authboss/v3/i18n"
+
+// Validator is a single pluggable validation rule. It receives the raw
+// value under test along with any parameters parsed out of the rule's
+// invocation (e.g. the tag `MinLength(8)` yields params = []string{"8"}),
+// and reports whether the value satisfies the rule.
+type Validator func(value string, params ...string) bool
+
+// MessageFunc renders the user-facing message for a failed Validator in
+// the given locale, via the application's i18n.Translator.
+type MessageFunc func(t i18n.Translator, locale, field string, params ...string) string
+
+// ValidatorRegistry holds named, pluggable validation rules. The defaults
+// package ships a catalog of common ones (required, email, url, min_len,
+// etc) registered against DefaultValidators, and applications can add
+// their own with RegisterValidation, much like go-playground/validator's
+// baked-in tags.
+type ValidatorRegistry struct {
+	validators map[string]Validator
+	messages   map[string]MessageFunc
+}
+
+// NewValidatorRegistry creates an empty ValidatorRegistry.
+func NewValidatorRegistry() *ValidatorRegistry {
+	return &ValidatorRegistry{
+		validators: make(map[string]Validator),
+		messages:   make(map[string]MessageFunc),
+	}
+}
+
+// DefaultValidators is the registry the defaults package registers its
+// built-in rule catalog into. Applications may register additional rules
+// here so they become available wherever a Rules.Validators name or a
+// struct `valid` tag references them.
+var DefaultValidators = NewValidatorRegistry()
+
+// RegisterValidation adds a named validator and its message renderer to
+// the registry, replacing any existing validator registered under the
+// same name.
+func (v *ValidatorRegistry) RegisterValidation(name string, fn Validator, msg MessageFunc) {
+	v.validators[name] = fn
+	v.messages[name] = msg
+}
+
+// Lookup returns the validator and message renderer registered under
+// name, or false if nothing is registered under that name.
+func (v *ValidatorRegistry) Lookup(name string) (fn Validator, msg MessageFunc, ok bool) {
+	fn, ok = v.validators[name]
+	if !ok {
+		return nil, nil, false
+	}
+	return fn, v.messages[name], true
+}
+
+// Validate runs the named validator against value, and if it fails,
+// renders its message via t in locale. ok is true when name isn't
+// registered at all, since an unknown rule name shouldn't block
+// validation of the rest of the fields.
+func (v *ValidatorRegistry) Validate(t i18n.Translator, locale, name, field, value string, params ...string) (ok bool, message string) {
+	fn, msg, found := v.Lookup(name)
+	if !found {
+		return true, ""
+	}
+	if fn(value, params...) {
+		return true, ""
+	}
+	if msg != nil {
+		return false, msg(t, locale, field, params...)
+	}
+	return false, t.Translate(locale, "%s is invalid", field)
+}
+
+// Names returns the registered validator names, for introspection by
+// struct-tag parsers and documentation.
+func (v *ValidatorRegistry) Names() []string {
+	names := make([]string, 0, len(v.validators))
+	for name := range v.validators {
+		names = append(names, name)
+	}
+	return names
+}